@@ -0,0 +1,178 @@
+package lang
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// localesFS embeds the default translation bundles shipped with the binary.
+// Downstream vendors can ship additional bundles at build time and register
+// them with RegisterCatalog without touching TextResource.
+//
+//go:embed locales/*.toml
+var localesFS embed.FS
+
+var (
+	catalogMutex   sync.RWMutex
+	bundle         = i18n.NewBundle(language.English)
+	unmarshalFuncs = map[string]i18n.UnmarshalFunc{"toml": toml.Unmarshal}
+)
+
+func init() {
+	for format, fn := range unmarshalFuncs {
+		bundle.RegisterUnmarshalFunc(format, fn)
+	}
+	if err := RegisterCatalog(localesFS); err != nil {
+		panic(fmt.Sprintf("lang: failed to load built-in catalog: %v", err))
+	}
+}
+
+// RegisterCatalog loads every *.toml translation bundle found in fs's
+// "locales" directory into the active catalog. A bundle's file name (minus
+// extension) must be a Language constant - e.g. "cn.toml" - or a BCP-47 tag;
+// that name, not go-i18n's own filename parsing, decides which language its
+// messages are registered under, since our Language codes (CN = "cn") aren't
+// always valid BCP-47 subtags (the ISO 639 code for Chinese is "zh"). Bundles
+// are merged, so a consumer embedding a custom embed.FS can extend or override
+// the built-in languages without forking this package.
+func RegisterCatalog(fs embed.FS) error {
+	entries, err := fs.ReadDir("locales")
+	if err != nil {
+		return fmt.Errorf("lang: read locales dir: %w", err)
+	}
+
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		data, err := fs.ReadFile("locales/" + name)
+		if err != nil {
+			return fmt.Errorf("lang: read %s: %w", name, err)
+		}
+		messageFile, err := i18n.ParseMessageFileBytes(data, name, unmarshalFuncs)
+		if err != nil {
+			return fmt.Errorf("lang: parse %s: %w", name, err)
+		}
+		tag, err := tagForBundleFile(name)
+		if err != nil {
+			return err
+		}
+		if err := bundle.AddMessages(tag, messageFile.Messages...); err != nil {
+			return fmt.Errorf("lang: register %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// tagForBundleFile maps a locale file's base name to the language.Tag its
+// messages should be registered under, preferring our own Language -> tag
+// table (see languageTags) over go-i18n's filename-based guess so that
+// non-BCP-47 Language codes like CN ("cn") still resolve to the right CLDR
+// plural rules and matcher entry ("zh-Hans").
+func tagForBundleFile(name string) (language.Tag, error) {
+	base := strings.TrimSuffix(name, path.Ext(name))
+	if tag, ok := languageTags[Language(base)]; ok {
+		return tag, nil
+	}
+	tag, err := language.Parse(base)
+	if err != nil {
+		return language.Und, fmt.Errorf("lang: %s is not a Language constant or a valid BCP-47 tag: %w", name, err)
+	}
+	return tag, nil
+}
+
+// localizerFor returns a go-i18n localizer for lang, falling back to EN. It
+// queries the bundle by lang's registered tag rather than its raw string
+// value, for the same reason tagForBundleFile does: "cn" isn't a BCP-47 tag
+// go-i18n's matcher can resolve to Simplified Chinese on its own.
+func localizerFor(l Language) *i18n.Localizer {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	query := string(l)
+	if tag, ok := languageTags[l]; ok {
+		query = tag.String()
+	}
+	return i18n.NewLocalizer(bundle, query, string(EN))
+}
+
+// T looks up id in the catalog for the current language and formats it with
+// args using fmt.Sprintf-style verbs. An id missing from the current language
+// falls back to its EN text; an id missing from the catalog altogether falls
+// back to the id itself.
+func T(id string, args ...any) string {
+	return translate(GetCurrentLanguage(), id, args...)
+}
+
+func translate(l Language, id string, args ...any) string {
+	msg, err := localizerFor(l).Localize(&i18n.LocalizeConfig{MessageID: id})
+	if err != nil {
+		return id
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Plural looks up id for the current language and selects the CLDR plural
+// category ("one", "few", "many", "other", ...) that n maps to, then formats
+// the result with args. Translation files key the variants of a message as
+// {id}.one, {id}.other, etc.; go-i18n resolves n to a category using plural
+// rules generated from the CLDR data also shipped with golang.org/x/text.
+// Missing ids fall back to the id itself, same as T.
+func Plural(id string, n int, args ...any) string {
+	return pluralize(GetCurrentLanguage(), id, n, args...)
+}
+
+func pluralize(l Language, id string, n int, args ...any) string {
+	msg, err := localizerFor(l).Localize(&i18n.LocalizeConfig{MessageID: id, PluralCount: n})
+	if err != nil {
+		return id
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// GetText returns the TextResource for the current language, assembled from
+// the catalog. Kept for backward compatibility with callers that still want
+// a struct of pre-resolved strings instead of calling T directly.
+func GetText() TextResource {
+	return textResourceFor(GetCurrentLanguage())
+}
+
+// textResourceFor assembles a TextResource from the catalog for l. It backs
+// both GetText and Registry.Snapshot so the two stay in lockstep.
+func textResourceFor(l Language) TextResource {
+	return TextResource{
+		SuccessMessage:     translate(l, "SuccessMessage"),
+		RestartMessage:     translate(l, "RestartMessage"),
+		ReadingConfig:      translate(l, "ReadingConfig"),
+		GeneratingIds:      translate(l, "GeneratingIds"),
+		CheckingProcesses:  translate(l, "CheckingProcesses"),
+		ClosingProcesses:   translate(l, "ClosingProcesses"),
+		ProcessesClosed:    translate(l, "ProcessesClosed"),
+		PleaseWait:         translate(l, "PleaseWait"),
+		ErrorPrefix:        translate(l, "ErrorPrefix"),
+		PrivilegeError:     translate(l, "PrivilegeError"),
+		RunAsAdmin:         translate(l, "RunAsAdmin"),
+		RunWithSudo:        translate(l, "RunWithSudo"),
+		SudoExample:        translate(l, "SudoExample"),
+		PressEnterToExit:   translate(l, "PressEnterToExit"),
+		SetReadOnlyMessage: translate(l, "SetReadOnlyMessage"),
+		ConfigLocation:     translate(l, "ConfigLocation"),
+	}
+}
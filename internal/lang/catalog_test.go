@@ -0,0 +1,27 @@
+package lang
+
+import "testing"
+
+func TestPluralSelectsCLDRCategory(t *testing.T) {
+	cases := []struct {
+		name string
+		lang Language
+		n    int
+		want string
+	}{
+		{"en one", EN, 1, "One Cursor instance has been closed"},
+		{"en other", EN, 5, "All Cursor instances have been closed"},
+		// Chinese has a single plural category, so both counts resolve to
+		// the same "other" form instead of a distinct "one" translation.
+		{"cn one", CN, 1, "所有 Cursor 进程已关闭"},
+		{"cn other", CN, 5, "所有 Cursor 进程已关闭"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := pluralize(c.lang, "ProcessesClosed", c.n); got != c.want {
+				t.Errorf("pluralize(%q, %q, %d) = %q, want %q", c.lang, "ProcessesClosed", c.n, got, c.want)
+			}
+		})
+	}
+}
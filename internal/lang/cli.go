@@ -0,0 +1,59 @@
+package lang
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/text/language"
+)
+
+// EnvOverride is the environment variable that, like the --lang flag,
+// overrides automatic locale detection. It takes effect where GUI/CLI flag
+// parsing isn't available, e.g. CI pipelines or misconfigured locales.
+const EnvOverride = "CURSOR_HELPER_LANG"
+
+// ApplyOverride resolves a user-requested language override, giving flagValue
+// priority over the CURSOR_HELPER_LANG environment variable. Both are
+// optional; if neither is set, detectLanguage still runs lazily on the first
+// GetCurrentLanguage call. An unrecognized tag leaves detection untouched and
+// prints a warning, in the fallback language, to stderr.
+//
+// main is expected to call this once, right after flag.Parse(), e.g.:
+//
+//	langFlag := flag.String("lang", "", "override the display language")
+//	flag.Parse()
+//	lang.ApplyOverride(*langFlag)
+func ApplyOverride(flagValue string) {
+	value := flagValue
+	if value == "" {
+		value = os.Getenv(EnvOverride)
+	}
+	if value == "" {
+		return
+	}
+
+	if err := SetLanguageFromTag(value); err != nil {
+		fmt.Fprintln(os.Stderr, translate(EN, "UnknownLangOverride", value))
+	}
+}
+
+// SetLanguageFromTag parses tag as a BCP-47 locale, matches it against the
+// supported languages (EN and CN, see supportedTags), and makes the match the
+// active language. Languages added only through RegisterCatalog are not
+// matched. It returns an error - without changing the active language - if
+// tag doesn't match a supported language.
+func SetLanguageFromTag(tag string) error {
+	parsed, err := language.Parse(normalizePOSIXLocale(tag))
+	if err != nil {
+		return fmt.Errorf("lang: invalid language tag %q: %w", tag, err)
+	}
+
+	matcher := language.NewMatcher(supportedTags())
+	_, index, confidence := matcher.Match(parsed)
+	if confidence == language.No {
+		return fmt.Errorf("lang: unsupported language tag %q", tag)
+	}
+
+	defaultRegistry.Set(languageForTag(supportedTags()[index]))
+	return nil
+}
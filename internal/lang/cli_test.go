@@ -0,0 +1,22 @@
+package lang
+
+import "testing"
+
+func TestSetLanguageFromTag(t *testing.T) {
+	t.Run("unsupported tag returns an error", func(t *testing.T) {
+		defaultRegistry = NewRegistry()
+		if err := SetLanguageFromTag("fr"); err == nil {
+			t.Fatal("SetLanguageFromTag(\"fr\") = nil error, want an error")
+		}
+	})
+
+	t.Run("regional variant resolves to the closest supported language", func(t *testing.T) {
+		defaultRegistry = NewRegistry()
+		if err := SetLanguageFromTag("zh-TW"); err != nil {
+			t.Fatalf("SetLanguageFromTag(\"zh-TW\") returned error: %v", err)
+		}
+		if got := GetCurrentLanguage(); got != CN {
+			t.Errorf("GetCurrentLanguage() = %q, want %q", got, CN)
+		}
+	})
+}
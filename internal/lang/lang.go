@@ -2,9 +2,9 @@ package lang
 
 import (
 	"os"
-	"os/exec"
 	"strings"
-	"sync"
+
+	"golang.org/x/text/language"
 )
 
 // Language represents a supported language code
@@ -46,115 +46,98 @@ type TextResource struct {
 	ConfigLocation string
 }
 
-var (
-	currentLanguage     Language
-	currentLanguageOnce sync.Once
-	languageMutex       sync.RWMutex
-)
-
-// GetCurrentLanguage returns the current language, detecting it if not already set
+// GetCurrentLanguage returns the current language, detecting it if not
+// already set. It reads from the package's defaultRegistry; see Registry for
+// concurrency-safe switching and change notifications.
 func GetCurrentLanguage() Language {
-	currentLanguageOnce.Do(func() {
-		currentLanguage = detectLanguage()
-	})
-
-	languageMutex.RLock()
-	defer languageMutex.RUnlock()
-	return currentLanguage
+	return defaultRegistry.Current()
 }
 
-// SetLanguage sets the current language
+// SetLanguage sets the current language on the defaultRegistry.
 func SetLanguage(lang Language) {
-	languageMutex.Lock()
-	defer languageMutex.Unlock()
-	currentLanguage = lang
+	defaultRegistry.Set(lang)
 }
 
-// GetText returns the TextResource for the current language
-func GetText() TextResource {
-	return texts[GetCurrentLanguage()]
+// languageTags maps each supported Language to the BCP-47 tag used to match
+// it against the detected locale.
+var languageTags = map[Language]language.Tag{
+	EN: language.English,
+	CN: language.SimplifiedChinese,
 }
 
-// detectLanguage detects the system language
+// detectLanguage follows the standard gettext locale precedence - LC_ALL,
+// LC_MESSAGES, LANGUAGE (a colon-separated fallback list), then LANG - and
+// returns the first candidate that parses and that language.Matcher matches
+// to a supported language, skipping candidates that match none. On Windows, where those variables are rarely set, it
+// falls back to the user's and system's default Win32 locale. Regional
+// variants (zh-HK, zh-TW, pt-BR, en-GB, ...) degrade gracefully to the
+// closest supported language instead of always falling through to EN.
 func detectLanguage() Language {
-	// Check environment variables first
-	if isChineseEnvVar() {
-		return CN
-	}
+	matcher := language.NewMatcher(supportedTags())
 
-	// Then check OS-specific locale
-	if isWindows() {
-		if isWindowsChineseLocale() {
-			return CN
+	for _, candidate := range localeCandidates() {
+		tag, err := language.Parse(normalizePOSIXLocale(candidate))
+		if err != nil {
+			continue
 		}
-	} else if isUnixChineseLocale() {
-		return CN
+		_, index, confidence := matcher.Match(tag)
+		if confidence == language.No {
+			continue
+		}
+		return languageForTag(supportedTags()[index])
 	}
 
 	return EN
 }
 
-func isChineseEnvVar() bool {
-	for _, envVar := range []string{"LANG", "LANGUAGE", "LC_ALL"} {
-		if lang := os.Getenv(envVar); lang != "" && strings.Contains(strings.ToLower(lang), "zh") {
-			return true
+// localeCandidates returns locale strings to try, in gettext precedence
+// order: LC_ALL, LC_MESSAGES, each entry of LANGUAGE, then LANG. On Windows
+// the Win32 user/system default locale names are appended as a last resort.
+func localeCandidates() []string {
+	var candidates []string
+
+	for _, envVar := range []string{"LC_ALL", "LC_MESSAGES"} {
+		if v := os.Getenv(envVar); v != "" {
+			candidates = append(candidates, v)
 		}
 	}
-	return false
-}
 
-func isWindows() bool {
-	return os.Getenv("OS") == "Windows_NT"
-}
+	if v := os.Getenv("LANGUAGE"); v != "" {
+		candidates = append(candidates, strings.Split(v, ":")...)
+	}
 
-func isWindowsChineseLocale() bool {
-	// Check Windows UI culture
-	cmd := exec.Command("powershell", "-Command",
-		"[System.Globalization.CultureInfo]::CurrentUICulture.Name")
-	output, err := cmd.Output()
-	if err == nil && strings.HasPrefix(strings.ToLower(strings.TrimSpace(string(output))), "zh") {
-		return true
+	if v := os.Getenv("LANG"); v != "" {
+		candidates = append(candidates, v)
 	}
 
-	// Check Windows locale
-	cmd = exec.Command("wmic", "os", "get", "locale")
-	output, err = cmd.Output()
-	return err == nil && strings.Contains(string(output), "2052")
+	candidates = append(candidates, windowsLocaleNames()...)
+
+	return candidates
 }
 
-func isUnixChineseLocale() bool {
-	cmd := exec.Command("locale")
-	output, err := cmd.Output()
-	return err == nil && strings.Contains(strings.ToLower(string(output)), "zh_cn")
+// supportedTags returns the BCP-47 tags of every registered Language, in a
+// stable order so Matcher indices line up with languageForTag.
+func supportedTags() []language.Tag {
+	return []language.Tag{languageTags[EN], languageTags[CN]}
 }
 
-// texts contains all translations
-var texts = map[Language]TextResource{
-	EN: {
-		// Success messages
-		SuccessMessage: "[√] Configuration file updated successfully!",
-		RestartMessage: "[!] Please restart Cursor manually for changes to take effect",
-
-		// Progress messages
-		ReadingConfig:     "Reading configuration file...",
-		GeneratingIds:     "Generating new identifiers...",
-		CheckingProcesses: "Checking for running Cursor instances...",
-		ClosingProcesses:  "Closing Cursor instances...",
-		ProcessesClosed:   "All Cursor instances have been closed",
-		PleaseWait:        "Please wait...",
-
-		// Error messages
-		ErrorPrefix:    "Program encountered a serious error: %v",
-		PrivilegeError: "\n[!] Error: Administrator privileges required",
-
-		// Instructions
-		RunAsAdmin:         "Please right-click and select 'Run as Administrator'",
-		RunWithSudo:        "Please run this program with sudo",
-		SudoExample:        "Example: sudo %s",
-		PressEnterToExit:   "\nPress Enter to exit...",
-		SetReadOnlyMessage: "Set storage.json to read-only mode, which will cause issues such as lost workspace records",
-
-		// Info messages
-		ConfigLocation: "Config file location:",
-	},
+// normalizePOSIXLocale turns a POSIX locale like "zh_CN.UTF-8@pinyin" into the
+// BCP-47 tag "zh-CN" that language.Parse expects.
+func normalizePOSIXLocale(locale string) string {
+	if locale == "C" || locale == "POSIX" {
+		return "en"
+	}
+	if i := strings.IndexAny(locale, ".@"); i != -1 {
+		locale = locale[:i]
+	}
+	return strings.ReplaceAll(locale, "_", "-")
+}
+
+func languageForTag(tag language.Tag) Language {
+	for lang, t := range languageTags {
+		if t == tag {
+			return lang
+		}
+	}
+	return EN
 }
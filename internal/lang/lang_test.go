@@ -0,0 +1,44 @@
+package lang
+
+import "testing"
+
+func TestNormalizePOSIXLocale(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"zh_CN.UTF-8@pinyin", "zh-CN"},
+		{"C", "en"},
+		{"POSIX", "en"},
+		{"en_US.UTF-8", "en-US"},
+		{"fr-FR", "fr-FR"},
+	}
+	for _, c := range cases {
+		if got := normalizePOSIXLocale(c.in); got != c.want {
+			t.Errorf("normalizePOSIXLocale(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDetectLanguagePrecedence(t *testing.T) {
+	t.Run("LC_ALL takes priority over LANG", func(t *testing.T) {
+		t.Setenv("LC_ALL", "zh_CN.UTF-8")
+		t.Setenv("LC_MESSAGES", "")
+		t.Setenv("LANGUAGE", "")
+		t.Setenv("LANG", "en_US.UTF-8")
+
+		if got := detectLanguage(); got != CN {
+			t.Errorf("detectLanguage() = %q, want %q", got, CN)
+		}
+	})
+
+	t.Run("LANGUAGE is a colon-separated fallback list", func(t *testing.T) {
+		t.Setenv("LC_ALL", "")
+		t.Setenv("LC_MESSAGES", "")
+		t.Setenv("LANGUAGE", "fr:zh_CN")
+		t.Setenv("LANG", "")
+
+		if got := detectLanguage(); got != CN {
+			t.Errorf("detectLanguage() = %q, want %q", got, CN)
+		}
+	})
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+
+package lang
+
+// windowsLocaleNames is a no-op outside Windows; locale detection there
+// relies entirely on the POSIX environment variables.
+func windowsLocaleNames() []string {
+	return nil
+}
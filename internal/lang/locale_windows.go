@@ -0,0 +1,46 @@
+//go:build windows
+
+package lang
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modkernel32                    = windows.NewLazySystemDLL("kernel32.dll")
+	procGetUserDefaultLocaleName   = modkernel32.NewProc("GetUserDefaultLocaleName")
+	procGetSystemDefaultLocaleName = modkernel32.NewProc("GetSystemDefaultLocaleName")
+)
+
+// localeNameMaxLength mirrors LOCALE_NAME_MAX_LENGTH from winnls.h.
+const localeNameMaxLength = 85
+
+// windowsLocaleNames returns the user's and, as a fallback, the system's
+// default locale as BCP-47 tags (e.g. "zh-CN"). It calls GetUserDefaultLocaleName
+// / GetSystemDefaultLocaleName directly instead of shelling out to powershell
+// or wmic, both of which are slow and wmic is gone on Windows 11.
+func windowsLocaleNames() []string {
+	var names []string
+	if name, ok := callGetLocaleName(procGetUserDefaultLocaleName); ok {
+		names = append(names, name)
+	}
+	if name, ok := callGetLocaleName(procGetSystemDefaultLocaleName); ok {
+		names = append(names, name)
+	}
+	return names
+}
+
+func callGetLocaleName(proc *windows.LazyProc) (string, bool) {
+	if err := proc.Find(); err != nil {
+		return "", false
+	}
+	buf := make([]uint16, localeNameMaxLength)
+	ret, _, _ := proc.Call(uintptr(unsafe.Pointer(&buf[0])), uintptr(localeNameMaxLength))
+	if ret == 0 {
+		return "", false
+	}
+	return syscall.UTF16ToString(buf), true
+}
@@ -0,0 +1,69 @@
+package lang
+
+import "sync"
+
+// Registry holds the active language for a process and notifies subscribers
+// synchronously whenever it changes, so long-running TUIs can re-render
+// their labels when the user switches language mid-run instead of staying
+// frozen to whatever was current at startup.
+type Registry struct {
+	mu          sync.RWMutex
+	once        sync.Once
+	current     Language
+	subscribers []func(Language)
+}
+
+// NewRegistry returns a Registry that lazily detects its language on first
+// use, exactly like the package-level GetCurrentLanguage always has.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Current returns the active language, running detectLanguage on the first
+// call if Set hasn't already been called.
+func (r *Registry) Current() Language {
+	r.once.Do(func() {
+		r.mu.Lock()
+		r.current = detectLanguage()
+		r.mu.Unlock()
+	})
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current
+}
+
+// Set makes lang the active language and notifies every subscriber. Calling
+// Set before the first Current() call suppresses auto-detection entirely,
+// which is how explicit overrides (see ApplyOverride) take precedence.
+func (r *Registry) Set(lang Language) {
+	r.once.Do(func() {})
+
+	r.mu.Lock()
+	r.current = lang
+	subscribers := append([]func(Language){}, r.subscribers...)
+	r.mu.Unlock()
+
+	for _, notify := range subscribers {
+		notify(lang)
+	}
+}
+
+// Subscribe registers fn to be called with the new language every time Set
+// changes it. fn runs synchronously on the goroutine that called Set.
+func (r *Registry) Subscribe(fn func(Language)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Snapshot returns an immutable TextResource for the currently active
+// language, so a goroutine can read and format a consistent set of strings
+// without holding the registry's mutex across formatting calls.
+func (r *Registry) Snapshot() TextResource {
+	return textResourceFor(r.Current())
+}
+
+// defaultRegistry backs the package-level GetCurrentLanguage/SetLanguage API
+// that most callers use instead of managing their own Registry.
+var defaultRegistry = NewRegistry()
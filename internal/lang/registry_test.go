@@ -0,0 +1,35 @@
+package lang
+
+import "testing"
+
+func TestRegistrySetNotifiesSubscribers(t *testing.T) {
+	r := NewRegistry()
+
+	var got Language
+	calls := 0
+	r.Subscribe(func(l Language) {
+		got = l
+		calls++
+	})
+
+	r.Set(CN)
+
+	if calls != 1 {
+		t.Fatalf("subscriber called %d times, want 1", calls)
+	}
+	if got != CN {
+		t.Errorf("subscriber received %q, want %q", got, CN)
+	}
+	if current := r.Current(); current != CN {
+		t.Errorf("r.Current() = %q, want %q", current, CN)
+	}
+}
+
+func TestRegistrySetBeforeFirstCurrentSuppressesDetection(t *testing.T) {
+	r := NewRegistry()
+	r.Set(CN)
+
+	if got := r.Current(); got != CN {
+		t.Errorf("r.Current() = %q, want %q (detectLanguage should not run)", got, CN)
+	}
+}